@@ -1,16 +1,20 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
-	"sort"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
+
+	"github.com/ewarrin/hive-ai/internal/hivedata"
 )
 
 // Colors
@@ -24,44 +28,66 @@ var (
 	styleRed     = tcell.StyleDefault.Foreground(tcell.ColorRed)
 )
 
-// Data structures
-type RunData struct {
-	RunID        string `json:"run_id"`
-	EpicID       string `json:"epic_id"`
-	Objective    string `json:"objective"`
-	Status       string `json:"status"`
-	CurrentAgent string `json:"current_agent"`
-	StartTime    int64  `json:"start_time"`
-}
+type screenMode int
 
-type Task struct {
-	ID     string `json:"id"`
-	Title  string `json:"title"`
-	Status string `json:"status"`
-}
-
-type CostData struct {
-	TotalCost float64 `json:"total_cost_usd"`
-}
+const (
+	screenMain screenMode = iota
+	screenPicker
+)
 
 type AppState struct {
 	hiveDir   string
 	runID     string
-	runData   RunData
-	tasks     []Task
-	cost      CostData
-	agents    []AgentStatus
+	runData   hivedata.RunData
+	tasks     []hivedata.Task
+	tasksErr  error
+	cost      hivedata.CostData
+	agents    []hivedata.AgentStatus
 	output    []string
 	lastFetch time.Time
+
+	vm viewModel
+
+	mode      screenMode
+	runs      []hivedata.RunSummary // all runs under .hive/runs, sorted newest-first
+	pickerSel int
+
+	events       *hivedata.EventState
+	taskProvider hivedata.TaskProvider
+}
+
+// viewModel holds render/interaction state that must survive a refresh,
+// kept separate from the data loaded off disk so a refresh never clobbers
+// where the cursor is or how far the user has scrolled into a log.
+type viewModel struct {
+	focus    int  // index into AppState.agents currently highlighted
+	expanded bool // true when the focused agent's log pane is expanded
+	follow   bool // true while the expanded pane is tailing the log file
+
+	scroll map[string]int   // per-agent scroll offset into its log lines
+	logPos map[string]int64 // per-agent byte offset already read from disk
+	logBuf map[string][]string
+
+	cancel  func() // kills the in-flight Ctrl-R rerun process, if any
+	message string // transient footer message, e.g. "nothing to cancel"
 }
 
-type AgentStatus struct {
-	Name    string
-	Done    bool
-	Running bool
+func newViewModel() viewModel {
+	return viewModel{
+		scroll: make(map[string]int),
+		logPos: make(map[string]int64),
+		logBuf: make(map[string][]string),
+	}
 }
 
+var agentNames = []string{"architect", "implementer", "ui-designer", "tester", "reviewer", "documenter"}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "status" {
+		runStatusCommand(os.Args[2:])
+		return
+	}
+
 	// Find .hive directory
 	hiveDir := os.Getenv("HIVE_DIR")
 	if hiveDir == "" {
@@ -73,8 +99,16 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Find latest run
-	runID := findLatestRun(hiveDir)
+	// Find latest run, unless we have a remembered run from a previous session
+	runID := ""
+	if st, err := hivedata.LoadTUIState(hiveDir); err == nil {
+		if _, statErr := os.Stat(filepath.Join(hiveDir, "runs", st.RunID)); statErr == nil {
+			runID = st.RunID
+		}
+	}
+	if runID == "" {
+		runID = hivedata.FindLatestRun(hiveDir)
+	}
 	if runID == "" {
 		fmt.Println("No runs found. Start a workflow with: hive run \"your objective\"")
 		os.Exit(0)
@@ -96,25 +130,37 @@ func main() {
 	screen.Clear()
 
 	// App state
+	agents := make([]hivedata.AgentStatus, len(agentNames))
+	for i, name := range agentNames {
+		agents[i] = hivedata.AgentStatus{Name: name}
+	}
 	state := &AppState{
-		hiveDir: hiveDir,
-		runID:   runID,
-		agents: []AgentStatus{
-			{Name: "architect"},
-			{Name: "implementer"},
-			{Name: "ui-designer"},
-			{Name: "tester"},
-			{Name: "reviewer"},
-			{Name: "documenter"},
-		},
+		hiveDir:      hiveDir,
+		runID:        runID,
+		agents:       agents,
+		vm:           newViewModel(),
+		events:       hivedata.NewEventState(),
+		taskProvider: hivedata.NewTaskProvider(hiveDir),
 	}
 
 	// Initial data load
+	state.runs = hivedata.LoadAllRuns(hiveDir)
 	state.refresh()
 
-	// Event loop
-	ticker := time.NewTicker(2 * time.Second)
-	defer ticker.Stop()
+	// Event loop. Refresh is driven by an fsnotify watcher over the files
+	// that actually change (see watch.go); a 10s heartbeat ticker is a
+	// safety net for filesystems that drop events (NFS, some overlayfs).
+	heartbeat := time.NewTicker(10 * time.Second)
+	defer heartbeat.Stop()
+
+	fw := newFileWatcher(hiveDir, state.runID)
+	defer fw.Close()
+
+	debounce := time.NewTimer(100 * time.Millisecond)
+	if !debounce.Stop() {
+		<-debounce.C
+	}
+	pending := make(map[watchCategory]bool)
 
 	eventChan := make(chan tcell.Event)
 	go func() {
@@ -124,6 +170,10 @@ func main() {
 	}()
 
 	for {
+		if fw.runID != state.runID {
+			fw.rebuild(state.runID)
+		}
+
 		render(screen, state)
 		screen.Show()
 
@@ -131,50 +181,344 @@ func main() {
 		case ev := <-eventChan:
 			switch ev := ev.(type) {
 			case *tcell.EventKey:
+				if state.mode == screenPicker {
+					if handlePickerKey(state, ev) {
+						return
+					}
+					break
+				}
+				state.vm.message = ""
 				switch ev.Key() {
-				case tcell.KeyEscape, tcell.KeyCtrlC:
+				case tcell.KeyEscape:
 					return
+				case tcell.KeyCtrlC:
+					state.cancelFocusedAgent()
+				case tcell.KeyCtrlR:
+					state.rerunFocusedAgent()
+				case tcell.KeyCtrlSpace:
+					suspendToStdout(screen, state)
+				case tcell.KeyUp:
+					state.moveFocus(-1)
+				case tcell.KeyDown:
+					state.moveFocus(1)
+				case tcell.KeyEnter:
+					state.toggleExpanded()
 				case tcell.KeyRune:
 					switch ev.Rune() {
 					case 'q', 'Q':
 						return
 					case 'r', 'R':
 						state.refresh()
+					case 'j':
+						state.moveFocus(1)
+					case 'k':
+						state.moveFocus(-1)
+					case 'l', 'L':
+						state.openPicker()
+					case '[':
+						state.stepRun(1)
+					case ']':
+						state.stepRun(-1)
 					}
 				}
 			case *tcell.EventResize:
 				screen.Sync()
 			}
-		case <-ticker.C:
+		case fsEv, ok := <-fw.Events():
+			if !ok {
+				break
+			}
+			if cat, ok := classifyWatchEvent(hiveDir, state.runID, fsEv.Name); ok {
+				pending[cat] = true
+				if !debounce.Stop() {
+					select {
+					case <-debounce.C:
+					default:
+					}
+				}
+				debounce.Reset(100 * time.Millisecond)
+			}
+		case <-fw.Errors():
+			// Best effort; the heartbeat ticker below covers missed events.
+		case <-debounce.C:
+			state.targetedRefresh(pending)
+			pending = make(map[watchCategory]bool)
+		case <-heartbeat.C:
 			state.refresh()
+			if state.vm.expanded && state.vm.follow {
+				state.tailFocusedLog()
+			}
 		}
 	}
 }
 
 func (s *AppState) refresh() {
-	s.runData = loadRunData(s.hiveDir)
-	s.tasks = loadTasks()
-	s.cost = loadCost(s.hiveDir, s.runID)
-	s.updateAgentStatus()
-	s.output = loadLatestOutput(s.hiveDir, s.runID, 6)
+	s.runData = hivedata.LoadRunData(s.hiveDir)
+	s.tasks, s.tasksErr = s.taskProvider.List(context.Background())
+	s.cost = hivedata.LoadCost(s.hiveDir, s.runID)
+	s.events.Consume(s.hiveDir, s.runID)
+	s.agents = hivedata.DeriveAgentStatus(s.hiveDir, s.runID, agentNames, s.runData, s.events.Events)
+	s.output = hivedata.LoadLatestOutput(s.hiveDir, s.runID, 6)
 	s.lastFetch = time.Now()
 }
 
-func (s *AppState) updateAgentStatus() {
-	outputDir := filepath.Join(s.hiveDir, "runs", s.runID, "output")
-	for i := range s.agents {
-		agentFile := filepath.Join(outputDir, s.agents[i].Name+".txt")
-		if _, err := os.Stat(agentFile); err == nil {
-			s.agents[i].Done = true
-			s.agents[i].Running = s.agents[i].Name == s.runData.CurrentAgent
-		} else {
-			s.agents[i].Done = false
-			s.agents[i].Running = s.agents[i].Name == s.runData.CurrentAgent
+// targetedRefresh reloads only the data behind whichever watched paths
+// actually changed, instead of doing a full refresh() on every fs event.
+func (s *AppState) targetedRefresh(changed map[watchCategory]bool) {
+	if changed[watchScratchpad] {
+		s.runData = hivedata.LoadRunData(s.hiveDir)
+	}
+	if changed[watchCost] {
+		s.cost = hivedata.LoadCost(s.hiveDir, s.runID)
+	}
+	if changed[watchEvents] {
+		s.events.Consume(s.hiveDir, s.runID)
+	}
+	if changed[watchOutput] || changed[watchEvents] || changed[watchScratchpad] {
+		s.agents = hivedata.DeriveAgentStatus(s.hiveDir, s.runID, agentNames, s.runData, s.events.Events)
+	}
+	if changed[watchOutput] {
+		s.output = hivedata.LoadLatestOutput(s.hiveDir, s.runID, 6)
+		if s.vm.expanded && s.vm.follow {
+			s.tailFocusedLog()
+		}
+	}
+	s.lastFetch = time.Now()
+}
+
+// openPicker switches to the run-picker screen, re-scanning .hive/runs so
+// the list reflects any runs started since the TUI launched.
+func (s *AppState) openPicker() {
+	s.runs = hivedata.LoadAllRuns(s.hiveDir)
+	s.pickerSel = 0
+	for i, r := range s.runs {
+		if r.RunID == s.runID {
+			s.pickerSel = i
+			break
+		}
+	}
+	s.mode = screenPicker
+}
+
+// selectRun switches the active run and reloads all data for it.
+func (s *AppState) selectRun(runID string) {
+	if runID == "" || runID == s.runID {
+		return
+	}
+	s.runID = runID
+	s.vm = newViewModel()
+	s.events.Reset()
+	s.refresh()
+	hivedata.SaveTUIState(s.hiveDir, hivedata.TUIState{RunID: runID})
+}
+
+// stepRun moves to the previous/next run in s.runs (sorted newest-first)
+// without going through the picker screen.
+func (s *AppState) stepRun(delta int) {
+	if len(s.runs) == 0 {
+		s.runs = hivedata.LoadAllRuns(s.hiveDir)
+	}
+	for i, r := range s.runs {
+		if r.RunID == s.runID {
+			next := i + delta
+			if next < 0 || next >= len(s.runs) {
+				return
+			}
+			s.selectRun(s.runs[next].RunID)
+			return
 		}
 	}
 }
 
+// handlePickerKey handles a key event while the run-picker screen is open.
+// It returns true if the whole program should exit.
+func handlePickerKey(s *AppState, ev *tcell.EventKey) bool {
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		s.mode = screenMain
+		return false
+	case tcell.KeyUp:
+		if s.pickerSel > 0 {
+			s.pickerSel--
+		}
+		return false
+	case tcell.KeyDown:
+		if s.pickerSel < len(s.runs)-1 {
+			s.pickerSel++
+		}
+		return false
+	case tcell.KeyEnter:
+		if s.pickerSel >= 0 && s.pickerSel < len(s.runs) {
+			s.selectRun(s.runs[s.pickerSel].RunID)
+		}
+		s.mode = screenMain
+		return false
+	case tcell.KeyRune:
+		switch ev.Rune() {
+		case 'q', 'Q':
+			return true
+		case 'j':
+			if s.pickerSel < len(s.runs)-1 {
+				s.pickerSel++
+			}
+		case 'k':
+			if s.pickerSel > 0 {
+				s.pickerSel--
+			}
+		}
+	}
+	return false
+}
+
+func (s *AppState) moveFocus(delta int) {
+	if len(s.agents) == 0 {
+		return
+	}
+	s.vm.focus = (s.vm.focus + delta + len(s.agents)) % len(s.agents)
+	s.vm.expanded = false
+	s.vm.follow = false
+}
+
+func (s *AppState) focusedAgent() string {
+	if s.vm.focus < 0 || s.vm.focus >= len(s.agents) {
+		return ""
+	}
+	return s.agents[s.vm.focus].Name
+}
+
+// toggleExpanded opens or closes the full-log pane for the focused agent.
+// Opening it resets the tail offset so the pane starts from the top of the
+// file and then follows new writes like `tail -f`.
+func (s *AppState) toggleExpanded() {
+	name := s.focusedAgent()
+	if name == "" {
+		return
+	}
+	s.vm.expanded = !s.vm.expanded
+	if s.vm.expanded {
+		s.vm.follow = true
+		s.vm.logPos[name] = 0
+		s.vm.logBuf[name] = nil
+		s.tailFocusedLog()
+	} else {
+		s.vm.follow = false
+	}
+}
+
+// tailFocusedLog reads any bytes appended to the focused agent's log file
+// since the last read and appends the resulting lines to logBuf, so the
+// expanded pane only ever re-reads what's new instead of the whole file.
+func (s *AppState) tailFocusedLog() {
+	name := s.focusedAgent()
+	if name == "" {
+		return
+	}
+	path := filepath.Join(s.hiveDir, "runs", s.runID, "output", name+".txt")
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return
+	}
+	offset := s.vm.logPos[name]
+	if info.Size() < offset {
+		// File was truncated/rotated; start over.
+		offset = 0
+		s.vm.logBuf[name] = nil
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return
+	}
+
+	buf := make([]byte, info.Size()-offset)
+	n, _ := f.Read(buf)
+	if n > 0 {
+		chunk := string(buf[:n])
+		s.vm.logBuf[name] = append(s.vm.logBuf[name], strings.Split(chunk, "\n")...)
+	}
+	s.vm.logPos[name] = offset + int64(n)
+}
+
+// rerunFocusedAgent shells out to `hive run --agent=<name>` in the
+// background so the TUI stays responsive while the agent restarts.
+func (s *AppState) rerunFocusedAgent() {
+	name := s.focusedAgent()
+	if name == "" {
+		return
+	}
+	if s.vm.cancel != nil {
+		s.vm.cancel()
+	}
+	cmd := exec.Command("hive", "run", "--agent="+name)
+	cmd.Dir = filepath.Dir(s.hiveDir)
+	if err := cmd.Start(); err != nil {
+		return
+	}
+	s.vm.cancel = func() {
+		cmd.Process.Kill()
+	}
+	go func() {
+		cmd.Wait()
+	}()
+}
+
+// cancelFocusedAgent kills the `hive run` process started by the most
+// recent rerun, if it is still tracked, whichever agent is focused now.
+// vm.cancel is only ever set by rerunFocusedAgent, so for an agent the
+// orchestrator itself is running
+// there's nothing in-process to kill; say so rather than silently doing
+// nothing.
+func (s *AppState) cancelFocusedAgent() {
+	if s.vm.cancel == nil {
+		s.vm.message = "nothing to cancel (agent not started from this TUI)"
+		return
+	}
+	s.vm.cancel()
+	s.vm.cancel = nil
+}
+
+// suspendToStdout drops out of the alternate screen and dumps the focused
+// agent's log to stdout in a `tail -f`-style follow loop until Ctrl-C,
+// then restores the TUI.
+//
+// screen.Suspend puts the terminal back in cooked mode, which re-enables
+// ISIG — so the Ctrl-C the user presses to stop `tail` is delivered as
+// SIGINT to the whole foreground process group, us included. Go's default
+// disposition for an unhandled SIGINT is to terminate immediately (skipping
+// our deferred screen.Resume/Fini), so we catch it on a channel for the
+// duration of the tail and let `tail` alone react to it. It is caught
+// rather than ignored because an ignored signal stays ignored in the
+// exec'd `tail`, whereas a caught one is reset to the default there.
+func suspendToStdout(screen tcell.Screen, state *AppState) {
+	name := state.focusedAgent()
+	if name == "" {
+		return
+	}
+	screen.Suspend()
+	defer screen.Resume()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT)
+	defer signal.Stop(sigs)
+
+	fmt.Printf("--- tailing %s (Ctrl-C to resume TUI) ---\n", name)
+	path := filepath.Join(state.hiveDir, "runs", state.runID, "output", name+".txt")
+	cmd := exec.Command("tail", "-f", path)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Run()
+}
+
 func render(s tcell.Screen, state *AppState) {
+	if state.mode == screenPicker {
+		renderPicker(s, state)
+		return
+	}
+
 	s.Clear()
 	w, h := s.Size()
 	row := 0
@@ -216,7 +560,7 @@ func render(s tcell.Screen, state *AppState) {
 	// Pipeline section
 	drawBox(s, 0, row, w-1, row+len(state.agents)+2, "Pipeline")
 	row++
-	for _, agent := range state.agents {
+	for i, agent := range state.agents {
 		icon := "○"
 		style := styleDim
 		if agent.Running {
@@ -227,7 +571,24 @@ func render(s tcell.Screen, state *AppState) {
 			style = styleGreen
 		}
 		drawText(s, 2, row, style, icon)
-		drawText(s, 4, row, styleDefault, agent.Name)
+		nameStyle := styleDefault
+		prefix := "  "
+		if i == state.vm.focus {
+			nameStyle = styleHeader
+			prefix = "> "
+		}
+		drawText(s, 4, row, nameStyle, prefix+agent.Name)
+
+		if ep, ok := state.events.Events[agent.Name]; ok && ep.Total > 0 {
+			barCol := 24
+			bar := progressBar(ep.Current, ep.Total, 20)
+			drawText(s, barCol, row, styleCyan, bar)
+			label := fmt.Sprintf("%d/%d", ep.Current, ep.Total)
+			if !ep.Done && ep.ETA > 0 {
+				label += fmt.Sprintf("  eta %s", ep.ETA.Round(time.Second))
+			}
+			drawText(s, barCol+22, row, styleDim, label)
+		}
 		row++
 	}
 	row += 2
@@ -238,10 +599,17 @@ func render(s tcell.Screen, state *AppState) {
 	if len(state.tasks) == 0 {
 		taskBoxHeight = 3
 	}
-	drawBox(s, 0, row, w-1, row+taskBoxHeight, "Tasks")
+	taskTitle := "Tasks"
+	if state.taskProvider != nil {
+		taskTitle = fmt.Sprintf("Tasks (%s)", state.taskProvider.Name())
+	}
+	drawBox(s, 0, row, w-1, row+taskBoxHeight, taskTitle)
 	row++
 
-	if len(state.tasks) == 0 {
+	if state.tasksErr != nil {
+		drawText(s, 2, row, styleRed, truncate(state.tasksErr.Error(), w-4))
+		row++
+	} else if len(state.tasks) == 0 {
 		drawText(s, 2, row, styleDim, "No tasks")
 		row++
 	} else {
@@ -270,27 +638,80 @@ func render(s tcell.Screen, state *AppState) {
 	}
 	row += 2
 
-	// Output section
-	outputBoxHeight := min(len(state.output), 6) + 2
-	if len(state.output) == 0 {
-		outputBoxHeight = 3
-	}
-	if row+outputBoxHeight < h-2 {
-		drawBox(s, 0, row, w-1, row+outputBoxHeight, "Live Output")
+	// Output section: the expanded log pane takes over this area when a
+	// pipeline entry has Enter pressed on it, otherwise it's the trailing
+	// 6-line summary as before.
+	if state.vm.expanded {
+		name := state.focusedAgent()
+		lines := state.vm.logBuf[name]
+		boxHeight := h - row - 2
+		if boxHeight < 3 {
+			boxHeight = 3
+		}
+		title := fmt.Sprintf("Log: %s", name)
+		if state.vm.follow {
+			title += " (following)"
+		}
+		drawBox(s, 0, row, w-1, row+boxHeight, title)
 		row++
+		visible := boxHeight - 1
+		start := 0
+		if len(lines) > visible {
+			start = len(lines) - visible
+		}
+		for _, line := range lines[start:] {
+			line = truncate(strings.TrimRight(line, "\r"), w-4)
+			drawText(s, 2, row, styleDim, line)
+			row++
+		}
+	} else {
+		outputBoxHeight := min(len(state.output), 6) + 2
 		if len(state.output) == 0 {
-			drawText(s, 2, row, styleDim, "No recent output")
-		} else {
-			for _, line := range state.output {
-				line = truncate(strings.TrimSpace(line), w-4)
-				drawText(s, 2, row, styleDim, line)
-				row++
+			outputBoxHeight = 3
+		}
+		if row+outputBoxHeight < h-2 {
+			drawBox(s, 0, row, w-1, row+outputBoxHeight, "Live Output")
+			row++
+			if len(state.output) == 0 {
+				drawText(s, 2, row, styleDim, "No recent output")
+			} else {
+				for _, line := range state.output {
+					line = truncate(strings.TrimSpace(line), w-4)
+					drawText(s, 2, row, styleDim, line)
+					row++
+				}
 			}
 		}
 	}
 
 	// Footer
-	drawText(s, 1, h-1, styleDim, "q: quit  r: refresh")
+	footer := "↑/↓ j/k: focus  Enter: expand log  Ctrl-R: rerun  Ctrl-C: cancel  Ctrl-Space: tail to stdout  q: quit  r: refresh"
+	footerStyle := styleDim
+	if state.vm.message != "" {
+		footer = state.vm.message
+		footerStyle = styleYellow
+	}
+	drawText(s, 1, h-1, footerStyle, footer)
+}
+
+// renderPicker draws the full-screen run-history browser opened with 'l'.
+func renderPicker(s tcell.Screen, state *AppState) {
+	s.Clear()
+	w, h := s.Size()
+
+	drawBox(s, 0, 0, w-1, h-1, "Run History")
+	rows := hivedata.FormatRunTable(state.runs)
+	for i, line := range rows {
+		style := styleDefault
+		if i == 0 {
+			style = styleDim
+		} else if i-1 == state.pickerSel {
+			style = styleHeader
+		}
+		drawText(s, 2, i+1, style, truncate(line, w-4))
+	}
+
+	drawText(s, 1, h-1, styleDim, "↑/↓ j/k: select  Enter: open  Esc: cancel  q: quit")
 }
 
 func drawBox(s tcell.Screen, x1, y1, x2, y2 int, title string) {
@@ -335,94 +756,33 @@ func min(a, b int) int {
 	return b
 }
 
-// Data loading functions
-
-func findLatestRun(hiveDir string) string {
-	runsDir := filepath.Join(hiveDir, "runs")
-	entries, err := os.ReadDir(runsDir)
-	if err != nil {
-		return ""
-	}
-
-	var runs []string
-	for _, e := range entries {
-		if e.IsDir() && !strings.Contains(e.Name(), "_subagents") {
-			runs = append(runs, e.Name())
-		}
+// progressBar renders current/total as a `width`-cell bar using eighth-block
+// characters for sub-cell resolution, the same glyph set terminal progress
+// bars in tools like btop use.
+func progressBar(current, total, width int) string {
+	if total <= 0 {
+		return strings.Repeat("░", width)
 	}
-
-	if len(runs) == 0 {
-		return ""
+	frac := float64(current) / float64(total)
+	if frac > 1 {
+		frac = 1
+	} else if frac < 0 {
+		frac = 0
 	}
 
-	sort.Sort(sort.Reverse(sort.StringSlice(runs)))
-	return runs[0]
-}
+	eighths := []rune{' ', '▏', '▎', '▍', '▌', '▋', '▊', '▉'}
+	totalEighths := int(frac*float64(width)*8 + 0.5)
+	full := totalEighths / 8
+	remainder := totalEighths % 8
 
-func loadRunData(hiveDir string) RunData {
-	var data RunData
-	path := filepath.Join(hiveDir, "scratchpad.json")
-	content, err := os.ReadFile(path)
-	if err != nil {
-		return data
+	var b strings.Builder
+	b.WriteString(strings.Repeat("█", full))
+	if full < width {
+		b.WriteRune(eighths[remainder])
+		full++
 	}
-	json.Unmarshal(content, &data)
-	return data
-}
-
-func loadTasks() []Task {
-	var tasks []Task
-	cmd := exec.Command("bd", "list", "--json")
-	output, err := cmd.Output()
-	if err != nil {
-		return tasks
-	}
-	json.Unmarshal(output, &tasks)
-	return tasks
-}
-
-func loadCost(hiveDir, runID string) CostData {
-	var cost CostData
-	path := filepath.Join(hiveDir, "runs", runID, "cost.json")
-	content, err := os.ReadFile(path)
-	if err != nil {
-		return cost
-	}
-	json.Unmarshal(content, &cost)
-	return cost
-}
-
-func loadLatestOutput(hiveDir, runID string, lines int) []string {
-	outputDir := filepath.Join(hiveDir, "runs", runID, "output")
-	entries, err := os.ReadDir(outputDir)
-	if err != nil {
-		return nil
-	}
-
-	var latest string
-	var latestTime time.Time
-	for _, e := range entries {
-		if strings.HasSuffix(e.Name(), ".txt") {
-			info, err := e.Info()
-			if err == nil && info.ModTime().After(latestTime) {
-				latestTime = info.ModTime()
-				latest = filepath.Join(outputDir, e.Name())
-			}
-		}
-	}
-
-	if latest == "" {
-		return nil
-	}
-
-	content, err := os.ReadFile(latest)
-	if err != nil {
-		return nil
-	}
-
-	allLines := strings.Split(string(content), "\n")
-	if len(allLines) <= lines {
-		return allLines
+	if full < width {
+		b.WriteString(strings.Repeat("░", width-full))
 	}
-	return allLines[len(allLines)-lines-1 : len(allLines)-1]
+	return b.String()
 }
@@ -0,0 +1,28 @@
+package main
+
+import "testing"
+
+func TestProgressBar(t *testing.T) {
+	tests := []struct {
+		name    string
+		current int
+		total   int
+		width   int
+		want    string
+	}{
+		{name: "no total yet renders empty track", current: 0, total: 0, width: 10, want: "░░░░░░░░░░"},
+		{name: "zero progress", current: 0, total: 10, width: 10, want: " ░░░░░░░░░"},
+		{name: "half progress lands on a whole block", current: 5, total: 10, width: 10, want: "█████ ░░░░"},
+		{name: "complete", current: 10, total: 10, width: 10, want: "██████████"},
+		{name: "current beyond total clamps to full", current: 15, total: 10, width: 4, want: "████"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := progressBar(tt.current, tt.total, tt.width)
+			if got != tt.want {
+				t.Fatalf("progressBar(%d, %d, %d) = %q, want %q", tt.current, tt.total, tt.width, got, tt.want)
+			}
+		})
+	}
+}
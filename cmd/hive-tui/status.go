@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ewarrin/hive-ai/internal/hivedata"
+)
+
+// statusSnapshot is one point-in-time read of a run, shared by the
+// text/json/prometheus formatters below.
+type statusSnapshot struct {
+	RunID        string                 `json:"run_id"`
+	EpicID       string                 `json:"epic_id"`
+	Status       string                 `json:"status"`
+	CurrentAgent string                 `json:"current_agent"`
+	CostUSD      float64                `json:"cost_usd"`
+	DurationSec  float64                `json:"duration_seconds"`
+	Agents       []hivedata.AgentStatus `json:"agents"`
+	Tasks        map[string]int         `json:"tasks_by_status"`
+	TaskTotal    int                    `json:"tasks_total"`
+}
+
+// runStatusCommand implements `hive-tui status [--format=text|json|prometheus] [--json]`,
+// a non-interactive snapshot for CI logs and scrape-based dashboards that
+// can't drive a full-screen TUI.
+func runStatusCommand(args []string) {
+	format := "text"
+	for _, a := range args {
+		switch {
+		case a == "--json":
+			format = "json"
+		case len(a) > len("--format=") && a[:len("--format=")] == "--format=":
+			format = a[len("--format="):]
+		}
+	}
+
+	hiveDir := os.Getenv("HIVE_DIR")
+	if hiveDir == "" {
+		hiveDir = ".hive"
+	}
+	if _, err := os.Stat(hiveDir); os.IsNotExist(err) {
+		fmt.Fprintln(os.Stderr, "No .hive directory found. Run 'hive init' first.")
+		os.Exit(1)
+	}
+
+	runID := hivedata.FindLatestRun(hiveDir)
+	if runID == "" {
+		fmt.Fprintln(os.Stderr, "No runs found.")
+		os.Exit(1)
+	}
+
+	snap := loadStatusSnapshot(hiveDir, runID)
+
+	switch format {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(snap)
+	case "prometheus":
+		printPrometheus(snap)
+	default:
+		printText(snap)
+	}
+}
+
+func loadStatusSnapshot(hiveDir, runID string) statusSnapshot {
+	runData := hivedata.LoadRunData(hiveDir)
+	cost := hivedata.LoadCost(hiveDir, runID)
+	tasks, _ := hivedata.NewTaskProvider(hiveDir).List(context.Background())
+
+	events := hivedata.NewEventState()
+	events.Consume(hiveDir, runID)
+	agents := hivedata.DeriveAgentStatus(hiveDir, runID, agentNames, runData, events.Events)
+
+	byStatus := make(map[string]int)
+	for _, t := range tasks {
+		byStatus[t.Status]++
+	}
+
+	var durationSec float64
+	if runData.StartTime > 0 {
+		durationSec = time.Since(time.Unix(runData.StartTime, 0)).Seconds()
+	}
+
+	return statusSnapshot{
+		RunID:        runID,
+		EpicID:       runData.EpicID,
+		Status:       runData.Status,
+		CurrentAgent: runData.CurrentAgent,
+		CostUSD:      cost.TotalCost,
+		DurationSec:  durationSec,
+		Agents:       agents,
+		Tasks:        byStatus,
+		TaskTotal:    len(tasks),
+	}
+}
+
+func printText(snap statusSnapshot) {
+	fmt.Printf("run:      %s\n", snap.RunID)
+	fmt.Printf("epic:     %s\n", snap.EpicID)
+	fmt.Printf("status:   %s\n", snap.Status)
+	fmt.Printf("cost:     $%.2f\n", snap.CostUSD)
+	fmt.Printf("duration: %s\n", time.Duration(snap.DurationSec*float64(time.Second)).Round(time.Second))
+	fmt.Println("agents:")
+	for _, a := range snap.Agents {
+		state := "pending"
+		if a.Running {
+			state = "running"
+		} else if a.Done {
+			state = "done"
+		}
+		fmt.Printf("  %-14s %s\n", a.Name, state)
+	}
+	fmt.Printf("tasks: %d total\n", snap.TaskTotal)
+	var statuses []string
+	for k := range snap.Tasks {
+		statuses = append(statuses, k)
+	}
+	sort.Strings(statuses)
+	for _, k := range statuses {
+		fmt.Printf("  %-14s %d\n", k, snap.Tasks[k])
+	}
+}
+
+// agentStateValue maps an agent's state to the 0|1|2 gauge value documented
+// on hive_agent_state: 0 pending, 1 running, 2 done.
+func agentStateValue(a hivedata.AgentStatus) int {
+	switch {
+	case a.Running:
+		return 1
+	case a.Done:
+		return 2
+	default:
+		return 0
+	}
+}
+
+func printPrometheus(snap statusSnapshot) {
+	fmt.Printf("# HELP hive_run_cost_usd Total cost in USD of the current run.\n")
+	fmt.Printf("# TYPE hive_run_cost_usd gauge\n")
+	fmt.Printf("hive_run_cost_usd %f\n", snap.CostUSD)
+
+	fmt.Printf("# HELP hive_run_duration_seconds Elapsed time since the run started.\n")
+	fmt.Printf("# TYPE hive_run_duration_seconds gauge\n")
+	fmt.Printf("hive_run_duration_seconds %f\n", snap.DurationSec)
+
+	fmt.Printf("# HELP hive_agent_state Agent pipeline state: 0=pending, 1=running, 2=done.\n")
+	fmt.Printf("# TYPE hive_agent_state gauge\n")
+	for _, a := range snap.Agents {
+		fmt.Printf("hive_agent_state{agent=%q} %d\n", a.Name, agentStateValue(a))
+	}
+
+	fmt.Printf("# HELP hive_tasks_total Number of tracked tasks by status.\n")
+	fmt.Printf("# TYPE hive_tasks_total gauge\n")
+	var statuses []string
+	for k := range snap.Tasks {
+		statuses = append(statuses, k)
+	}
+	sort.Strings(statuses)
+	for _, k := range statuses {
+		fmt.Printf("hive_tasks_total{status=%q} %d\n", k, snap.Tasks[k])
+	}
+}
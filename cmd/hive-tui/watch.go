@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchCategory identifies which piece of run state a watched path feeds,
+// so a targetedRefresh only reloads what actually changed.
+type watchCategory int
+
+const (
+	watchScratchpad watchCategory = iota
+	watchCost
+	watchEvents
+	watchOutput
+)
+
+// fileWatcher wraps an fsnotify.Watcher scoped to a single run, watching
+// .hive/scratchpad.json, .hive/runs/<id>/cost.json, .hive/runs/<id>/events.jsonl,
+// and .hive/runs/<id>/output/ so the TUI no longer has to poll on a ticker.
+type fileWatcher struct {
+	hiveDir string
+	runID   string
+	w       *fsnotify.Watcher // nil if fsnotify couldn't start; heartbeat covers us
+}
+
+func newFileWatcher(hiveDir, runID string) *fileWatcher {
+	fw := &fileWatcher{hiveDir: hiveDir}
+	fw.rebuild(runID)
+	return fw
+}
+
+// rebuild tears down the current watcher, if any, and watches the given
+// run's paths instead. Called whenever the active run changes (picker,
+// '[' / ']', or a restored tui-state.json run).
+func (fw *fileWatcher) rebuild(runID string) {
+	if fw.w != nil {
+		fw.w.Close()
+		fw.w = nil
+	}
+	fw.runID = runID
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fsnotify unavailable (%v); falling back to the heartbeat ticker\n", err)
+		return
+	}
+
+	for _, path := range []string{
+		filepath.Join(fw.hiveDir, "scratchpad.json"),
+		filepath.Join(fw.hiveDir, "runs", runID, "cost.json"),
+		filepath.Join(fw.hiveDir, "runs", runID, "events.jsonl"),
+		filepath.Join(fw.hiveDir, "runs", runID, "output"),
+	} {
+		// Best effort: a path that doesn't exist yet (e.g. cost.json before
+		// the first cost report) is simply skipped; the heartbeat ticker
+		// will pick up changes to it once it appears.
+		_ = w.Add(path)
+	}
+	fw.w = w
+}
+
+func (fw *fileWatcher) Events() <-chan fsnotify.Event {
+	if fw.w == nil {
+		return nil
+	}
+	return fw.w.Events
+}
+
+func (fw *fileWatcher) Errors() <-chan error {
+	if fw.w == nil {
+		return nil
+	}
+	return fw.w.Errors
+}
+
+func (fw *fileWatcher) Close() {
+	if fw.w != nil {
+		fw.w.Close()
+	}
+}
+
+// classifyWatchEvent maps a changed path to the run-state category it
+// feeds; the bool is false for paths we don't care about.
+func classifyWatchEvent(hiveDir, runID, path string) (watchCategory, bool) {
+	switch path {
+	case filepath.Join(hiveDir, "scratchpad.json"):
+		return watchScratchpad, true
+	case filepath.Join(hiveDir, "runs", runID, "cost.json"):
+		return watchCost, true
+	case filepath.Join(hiveDir, "runs", runID, "events.jsonl"):
+		return watchEvents, true
+	}
+	if strings.HasPrefix(path, filepath.Join(hiveDir, "runs", runID, "output")+string(filepath.Separator)) {
+		return watchOutput, true
+	}
+	return 0, false
+}
@@ -0,0 +1,401 @@
+// Package hivedata loads and derives hive run state from a .hive directory
+// without touching the terminal. It backs both the hive-tui dashboard and
+// the `hive-tui status` machine-readable snapshot, so a CI job or a
+// Prometheus sidecar can read the same data the TUI renders.
+package hivedata
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// RunData is the orchestrator's scratchpad for the run currently in
+// progress (or, for an archived run, its own frozen copy of the same file).
+type RunData struct {
+	RunID        string `json:"run_id"`
+	EpicID       string `json:"epic_id"`
+	Objective    string `json:"objective"`
+	Status       string `json:"status"`
+	CurrentAgent string `json:"current_agent"`
+	StartTime    int64  `json:"start_time"`
+}
+
+type Task struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
+
+type CostData struct {
+	TotalCost float64 `json:"total_cost_usd"`
+}
+
+// AgentStatus is the rendered state of one pipeline agent.
+type AgentStatus struct {
+	Name    string
+	Done    bool
+	Running bool
+}
+
+// AgentEvent is one record of the append-only .hive/runs/<id>/events.jsonl
+// stream the orchestrator writes as agents work.
+type AgentEvent struct {
+	Ts      int64  `json:"ts"`
+	Agent   string `json:"agent"`
+	Type    string `json:"type"` // task_start | task_progress | task_done | token
+	Current int    `json:"current"`
+	Total   int    `json:"total"`
+	Msg     string `json:"msg"`
+}
+
+// AgentProgress is the derived, per-agent state kept from tailing
+// events.jsonl: a live current/total plus an EWMA-smoothed ETA.
+type AgentProgress struct {
+	Current  int
+	Total    int
+	Done     bool
+	started  bool // true once a task_start has been seen; StartTs==0 is a valid timestamp, not "unset"
+	StartTs  int64
+	EWMARate float64 // seconds per completed unit of work
+	ETA      time.Duration
+}
+
+// ewmaAlpha weights how much a fresh rate sample moves the running ETA
+// estimate; low enough that one slow/fast task_progress tick doesn't
+// whipsaw the displayed ETA.
+const ewmaAlpha = 0.3
+
+// RunSummary is one row of the run-picker / history browser.
+type RunSummary struct {
+	RunID    string
+	EpicID   string
+	Status   string
+	Cost     float64
+	Duration time.Duration
+	ModTime  time.Time
+}
+
+// TUIState is persisted to .hive/tui-state.json so reopening the TUI
+// resumes on the run the user was last looking at.
+type TUIState struct {
+	RunID string `json:"run_id"`
+}
+
+// EventState tails a run's events.jsonl across repeated Consume calls,
+// folding new records into a live per-agent progress map.
+type EventState struct {
+	Events map[string]*AgentProgress
+	pos    int64
+	Exists bool
+}
+
+func NewEventState() *EventState {
+	return &EventState{Events: make(map[string]*AgentProgress)}
+}
+
+// Consume reads any bytes appended to events.jsonl since the last call and
+// applies each record. Missing events.jsonl (old runs) just leaves
+// Exists false so callers can fall back to file-existence-based status.
+func (e *EventState) Consume(hiveDir, runID string) {
+	path := filepath.Join(hiveDir, "runs", runID, "events.jsonl")
+	f, err := os.Open(path)
+	if err != nil {
+		e.Exists = false
+		return
+	}
+	defer f.Close()
+	e.Exists = true
+
+	info, err := f.Stat()
+	if err != nil {
+		return
+	}
+	if info.Size() < e.pos {
+		// Rotated/truncated; re-read from scratch.
+		e.pos = 0
+		e.Events = make(map[string]*AgentProgress)
+	}
+	if _, err := f.Seek(e.pos, io.SeekStart); err != nil {
+		return
+	}
+	buf := make([]byte, info.Size()-e.pos)
+	n, _ := f.Read(buf)
+	e.pos += int64(n)
+
+	for _, line := range strings.Split(string(buf[:n]), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var ev AgentEvent
+		if err := json.Unmarshal([]byte(line), &ev); err != nil {
+			continue
+		}
+		e.apply(ev)
+	}
+}
+
+// Reset clears all tracked progress, used when the caller switches runs.
+func (e *EventState) Reset() {
+	e.Events = make(map[string]*AgentProgress)
+	e.pos = 0
+	e.Exists = false
+}
+
+func (e *EventState) apply(ev AgentEvent) {
+	ep, ok := e.Events[ev.Agent]
+	if !ok {
+		ep = &AgentProgress{}
+		e.Events[ev.Agent] = ep
+	}
+
+	switch ev.Type {
+	case "task_start":
+		ep.started = true
+		ep.StartTs = ev.Ts
+		ep.Current = 0
+		ep.Total = 0
+		ep.Done = false
+		ep.EWMARate = 0
+		ep.ETA = 0
+	case "task_progress", "token":
+		ep.Current = ev.Current
+		ep.Total = ev.Total
+		if ep.started && ev.Current > 0 {
+			elapsed := float64(ev.Ts - ep.StartTs)
+			instant := elapsed / float64(ev.Current)
+			if ep.EWMARate == 0 {
+				ep.EWMARate = instant
+			} else {
+				ep.EWMARate = ewmaAlpha*instant + (1-ewmaAlpha)*ep.EWMARate
+			}
+			if ep.Total > ep.Current {
+				ep.ETA = time.Duration(float64(ep.Total-ep.Current) * ep.EWMARate * float64(time.Second))
+			} else {
+				ep.ETA = 0
+			}
+		}
+	case "task_done":
+		ep.Done = true
+		if ep.Total > 0 {
+			ep.Current = ep.Total
+		}
+		ep.ETA = 0
+	}
+}
+
+// DeriveAgentStatus computes Done/Running for each named agent. When
+// events.jsonl is being written for an agent it's authoritative; otherwise
+// this falls back to the old file-existence check so pre-events runs still
+// render correctly.
+func DeriveAgentStatus(hiveDir, runID string, names []string, runData RunData, events map[string]*AgentProgress) []AgentStatus {
+	outputDir := filepath.Join(hiveDir, "runs", runID, "output")
+	statuses := make([]AgentStatus, len(names))
+	for i, name := range names {
+		agentFile := filepath.Join(outputDir, name+".txt")
+		_, fileErr := os.Stat(agentFile)
+		statuses[i].Name = name
+
+		if ep, ok := events[name]; ok {
+			statuses[i].Done = ep.Done || (fileErr == nil && ep.Total > 0 && ep.Current >= ep.Total)
+			statuses[i].Running = !statuses[i].Done && name == runData.CurrentAgent
+			continue
+		}
+
+		statuses[i].Done = fileErr == nil
+		statuses[i].Running = name == runData.CurrentAgent
+	}
+	return statuses
+}
+
+func FindLatestRun(hiveDir string) string {
+	runsDir := filepath.Join(hiveDir, "runs")
+	entries, err := os.ReadDir(runsDir)
+	if err != nil {
+		return ""
+	}
+
+	var runs []string
+	for _, e := range entries {
+		if e.IsDir() && !strings.Contains(e.Name(), "_subagents") {
+			runs = append(runs, e.Name())
+		}
+	}
+
+	if len(runs) == 0 {
+		return ""
+	}
+
+	sort.Sort(sort.Reverse(sort.StringSlice(runs)))
+	return runs[0]
+}
+
+// LoadAllRuns scans .hive/runs for the history browser, sorted newest-first
+// by directory mtime. Each run's own archived scratchpad.json (if present)
+// supplies its epic/status; cost.json supplies the cost and RunDuration the
+// duration.
+func LoadAllRuns(hiveDir string) []RunSummary {
+	runsDir := filepath.Join(hiveDir, "runs")
+	entries, err := os.ReadDir(runsDir)
+	if err != nil {
+		return nil
+	}
+
+	var runs []RunSummary
+	for _, e := range entries {
+		if !e.IsDir() || strings.Contains(e.Name(), "_subagents") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		runs = append(runs, RunSummary{
+			RunID:    e.Name(),
+			ModTime:  info.ModTime(),
+			Duration: RunDuration(hiveDir, e.Name(), info.ModTime()),
+		})
+	}
+
+	for i := range runs {
+		rd := LoadArchivedRunData(hiveDir, runs[i].RunID)
+		runs[i].EpicID = rd.EpicID
+		runs[i].Status = rd.Status
+		runs[i].Cost = LoadCost(hiveDir, runs[i].RunID).TotalCost
+	}
+
+	sort.Slice(runs, func(i, j int) bool { return runs[i].ModTime.After(runs[j].ModTime) })
+	return runs
+}
+
+// LoadArchivedRunData reads a run's own copy of scratchpad.json, falling
+// back to the live one at the top of .hive for any run that has no copy of
+// its own (so such a run reports the live run's epic and status).
+func LoadArchivedRunData(hiveDir, runID string) RunData {
+	path := filepath.Join(hiveDir, "runs", runID, "scratchpad.json")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return LoadRunData(hiveDir)
+	}
+	var data RunData
+	json.Unmarshal(content, &data)
+	return data
+}
+
+// RunDuration reports how long a run has taken. For a run still in
+// progress, dirModTime (the run directory's own mtime) only advances when
+// an entry is created directly under runs/<id>/ — not when files already
+// created under output/ are merely appended to — so it lags real elapsed
+// time; time.Since(start) tracks it properly. A finished run has a stable
+// dirModTime, so that's used instead to keep historical durations fixed.
+func RunDuration(hiveDir, runID string, dirModTime time.Time) time.Duration {
+	rd := LoadArchivedRunData(hiveDir, runID)
+	if rd.StartTime == 0 {
+		return 0
+	}
+	start := time.Unix(rd.StartTime, 0)
+	switch rd.Status {
+	case "complete", "failed":
+		if dirModTime.Before(start) {
+			return 0
+		}
+		return dirModTime.Sub(start)
+	default:
+		return time.Since(start)
+	}
+}
+
+// FormatRunTable renders run summaries as aligned columns using
+// text/tabwriter, the same approach `glab`/`gh` style table views use.
+func FormatRunTable(runs []RunSummary) []string {
+	var buf strings.Builder
+	tw := tabwriter.NewWriter(&buf, 2, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "RUN ID\tEPIC\tSTATUS\tCOST\tDURATION")
+	for _, r := range runs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t$%.2f\t%s\n", r.RunID, r.EpicID, r.Status, r.Cost, r.Duration.Round(time.Second))
+	}
+	tw.Flush()
+	return strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+}
+
+func LoadTUIState(hiveDir string) (TUIState, error) {
+	var st TUIState
+	content, err := os.ReadFile(filepath.Join(hiveDir, "tui-state.json"))
+	if err != nil {
+		return st, err
+	}
+	err = json.Unmarshal(content, &st)
+	return st, err
+}
+
+func SaveTUIState(hiveDir string, st TUIState) error {
+	content, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(hiveDir, "tui-state.json"), content, 0644)
+}
+
+func LoadRunData(hiveDir string) RunData {
+	var data RunData
+	path := filepath.Join(hiveDir, "scratchpad.json")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return data
+	}
+	json.Unmarshal(content, &data)
+	return data
+}
+
+func LoadCost(hiveDir, runID string) CostData {
+	var cost CostData
+	path := filepath.Join(hiveDir, "runs", runID, "cost.json")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return cost
+	}
+	json.Unmarshal(content, &cost)
+	return cost
+}
+
+func LoadLatestOutput(hiveDir, runID string, lines int) []string {
+	outputDir := filepath.Join(hiveDir, "runs", runID, "output")
+	entries, err := os.ReadDir(outputDir)
+	if err != nil {
+		return nil
+	}
+
+	var latest string
+	var latestTime time.Time
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), ".txt") {
+			info, err := e.Info()
+			if err == nil && info.ModTime().After(latestTime) {
+				latestTime = info.ModTime()
+				latest = filepath.Join(outputDir, e.Name())
+			}
+		}
+	}
+
+	if latest == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(latest)
+	if err != nil {
+		return nil
+	}
+
+	allLines := strings.Split(string(content), "\n")
+	if len(allLines) <= lines {
+		return allLines
+	}
+	return allLines[len(allLines)-lines-1 : len(allLines)-1]
+}
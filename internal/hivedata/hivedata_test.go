@@ -0,0 +1,63 @@
+package hivedata
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEventStateApply(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []AgentEvent
+		want   AgentProgress
+	}{
+		{
+			name: "task_start resets progress",
+			events: []AgentEvent{
+				{Agent: "tester", Type: "task_start", Ts: 100},
+			},
+			want: AgentProgress{StartTs: 100},
+		},
+		{
+			name: "task_progress computes an EWMA rate and ETA",
+			events: []AgentEvent{
+				{Agent: "tester", Type: "task_start", Ts: 0},
+				{Agent: "tester", Type: "task_progress", Ts: 10, Current: 5, Total: 10},
+			},
+			// instant rate = 10s / 5 units = 2s/unit; first sample seeds EWMARate.
+			// ETA = (10-5) * 2s = 10s.
+			want: AgentProgress{Current: 5, Total: 10, EWMARate: 2, ETA: 10 * time.Second},
+		},
+		{
+			name: "task_progress at total leaves no ETA",
+			events: []AgentEvent{
+				{Agent: "tester", Type: "task_start", Ts: 0},
+				{Agent: "tester", Type: "task_progress", Ts: 10, Current: 10, Total: 10},
+			},
+			want: AgentProgress{Current: 10, Total: 10, EWMARate: 1, ETA: 0},
+		},
+		{
+			name: "task_done marks complete and clears ETA",
+			events: []AgentEvent{
+				{Agent: "tester", Type: "task_start", Ts: 0},
+				{Agent: "tester", Type: "task_progress", Ts: 10, Current: 5, Total: 10},
+				{Agent: "tester", Type: "task_done", Ts: 20},
+			},
+			want: AgentProgress{Current: 10, Total: 10, Done: true, EWMARate: 2, ETA: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEventState()
+			for _, ev := range tt.events {
+				e.apply(ev)
+			}
+			got := *e.Events["tester"]
+			if got.Current != tt.want.Current || got.Total != tt.want.Total || got.Done != tt.want.Done ||
+				got.EWMARate != tt.want.EWMARate || got.ETA != tt.want.ETA || got.StartTs != tt.want.StartTs {
+				t.Fatalf("apply() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package hivedata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+)
+
+// TaskProvider abstracts over whatever backend a project tracks its tasks
+// in, so the TUI doesn't hard-code a single CLI. Selected via
+// .hive/config.toml's [tasks] section.
+type TaskProvider interface {
+	// Name is shown in the Tasks panel header, e.g. "bd", "github", "gitlab".
+	Name() string
+	List(ctx context.Context) ([]Task, error)
+}
+
+// TaskConfig is the [tasks] section of .hive/config.toml.
+type TaskConfig struct {
+	Tasks struct {
+		Backend string `toml:"backend"`
+		Repo    string `toml:"repo"`
+		Label   string `toml:"label"`
+		AuthEnv string `toml:"auth_env"`
+	} `toml:"tasks"`
+}
+
+// LoadTaskConfig reads .hive/config.toml. A missing file is not an error —
+// it just means the default "bd" backend applies.
+func LoadTaskConfig(hiveDir string) TaskConfig {
+	var cfg TaskConfig
+	toml.DecodeFile(filepath.Join(hiveDir, "config.toml"), &cfg)
+	return cfg
+}
+
+// NewTaskProvider resolves the configured backend into a TaskProvider,
+// defaulting to "bd" when .hive/config.toml has no [tasks] section.
+func NewTaskProvider(hiveDir string) TaskProvider {
+	cfg := LoadTaskConfig(hiveDir)
+	switch cfg.Tasks.Backend {
+	case "github":
+		return &githubTaskProvider{repo: cfg.Tasks.Repo, label: cfg.Tasks.Label, authEnv: cfg.Tasks.AuthEnv}
+	case "gitlab":
+		return &gitlabTaskProvider{repo: cfg.Tasks.Repo, label: cfg.Tasks.Label, authEnv: cfg.Tasks.AuthEnv}
+	case "file":
+		return &fileTaskProvider{hiveDir: hiveDir}
+	case "bd", "":
+		return &bdTaskProvider{}
+	default:
+		return &bdTaskProvider{}
+	}
+}
+
+type bdTaskProvider struct{}
+
+func (bdTaskProvider) Name() string { return "bd" }
+
+func (bdTaskProvider) List(ctx context.Context) ([]Task, error) {
+	var tasks []Task
+	cmd := exec.CommandContext(ctx, "bd", "list", "--json")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("bd list --json: %w", err)
+	}
+	if err := json.Unmarshal(output, &tasks); err != nil {
+		return nil, fmt.Errorf("parsing bd output: %w", err)
+	}
+	return tasks, nil
+}
+
+// githubIssue is the subset of `gh issue list --json` fields we map to Task.
+type githubIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+}
+
+type githubTaskProvider struct {
+	repo    string
+	label   string
+	authEnv string // name of the env var holding a token for GH_TOKEN, e.g. "HIVE_GITHUB_TOKEN"
+}
+
+func (githubTaskProvider) Name() string { return "github" }
+
+func (p githubTaskProvider) List(ctx context.Context) ([]Task, error) {
+	args := []string{"issue", "list", "--json", "number,title,state"}
+	if p.repo != "" {
+		args = append(args, "--repo", p.repo)
+	}
+	if p.label != "" {
+		args = append(args, "--label", p.label)
+	}
+	cmd := exec.CommandContext(ctx, "gh", args...)
+	if token := os.Getenv(p.authEnv); token != "" {
+		cmd.Env = append(os.Environ(), "GH_TOKEN="+token)
+	}
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("gh issue list: %w", err)
+	}
+	var issues []githubIssue
+	if err := json.Unmarshal(output, &issues); err != nil {
+		return nil, fmt.Errorf("parsing gh output: %w", err)
+	}
+	tasks := make([]Task, len(issues))
+	for i, iss := range issues {
+		tasks[i] = Task{
+			ID:     fmt.Sprintf("#%d", iss.Number),
+			Title:  iss.Title,
+			Status: githubStatus(iss.State),
+		}
+	}
+	return tasks, nil
+}
+
+func githubStatus(state string) string {
+	switch state {
+	case "CLOSED":
+		return "closed"
+	default:
+		return "open"
+	}
+}
+
+// gitlabIssue is the subset of `glab issue list -F json` fields we map to Task.
+type gitlabIssue struct {
+	IID   int    `json:"iid"`
+	Title string `json:"title"`
+	State string `json:"state"`
+}
+
+type gitlabTaskProvider struct {
+	repo    string
+	label   string
+	authEnv string // name of the env var holding a token for GITLAB_TOKEN
+}
+
+func (gitlabTaskProvider) Name() string { return "gitlab" }
+
+func (p gitlabTaskProvider) List(ctx context.Context) ([]Task, error) {
+	args := []string{"issue", "list", "-F", "json"}
+	if p.repo != "" {
+		args = append(args, "-R", p.repo)
+	}
+	if p.label != "" {
+		args = append(args, "--label", p.label)
+	}
+	cmd := exec.CommandContext(ctx, "glab", args...)
+	if token := os.Getenv(p.authEnv); token != "" {
+		cmd.Env = append(os.Environ(), "GITLAB_TOKEN="+token)
+	}
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("glab issue list: %w", err)
+	}
+	var issues []gitlabIssue
+	if err := json.Unmarshal(output, &issues); err != nil {
+		return nil, fmt.Errorf("parsing glab output: %w", err)
+	}
+	tasks := make([]Task, len(issues))
+	for i, iss := range issues {
+		tasks[i] = Task{
+			ID:     fmt.Sprintf("!%d", iss.IID),
+			Title:  iss.Title,
+			Status: gitlabStatus(iss.State),
+		}
+	}
+	return tasks, nil
+}
+
+func gitlabStatus(state string) string {
+	switch state {
+	case "closed":
+		return "closed"
+	default:
+		return "open"
+	}
+}
+
+// fileTaskProvider reads a plain .hive/tasks.json, for projects with no
+// issue tracker wired up at all.
+type fileTaskProvider struct {
+	hiveDir string
+}
+
+func (fileTaskProvider) Name() string { return "file" }
+
+func (p fileTaskProvider) List(ctx context.Context) ([]Task, error) {
+	content, err := os.ReadFile(filepath.Join(p.hiveDir, "tasks.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading tasks.json: %w", err)
+	}
+	var tasks []Task
+	if err := json.Unmarshal(content, &tasks); err != nil {
+		return nil, fmt.Errorf("parsing tasks.json: %w", err)
+	}
+	return tasks, nil
+}
@@ -0,0 +1,87 @@
+package hivedata
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeConfig(t *testing.T, hiveDir, contents string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(hiveDir, "config.toml"), []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewTaskProvider(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		wantName    string
+		wantRepo    string
+		wantLabel   string
+		wantAuthEnv string
+	}{
+		{
+			name:     "no config defaults to bd",
+			config:   "",
+			wantName: "bd",
+		},
+		{
+			name:     "empty backend defaults to bd",
+			config:   "[tasks]\nbackend = \"\"\n",
+			wantName: "bd",
+		},
+		{
+			name:     "unknown backend falls back to bd",
+			config:   "[tasks]\nbackend = \"jira\"\n",
+			wantName: "bd",
+		},
+		{
+			name:        "github backend carries repo, label, and auth_env",
+			config:      "[tasks]\nbackend = \"github\"\nrepo = \"acme/widgets\"\nlabel = \"bug\"\nauth_env = \"HIVE_GH_TOKEN\"\n",
+			wantName:    "github",
+			wantRepo:    "acme/widgets",
+			wantLabel:   "bug",
+			wantAuthEnv: "HIVE_GH_TOKEN",
+		},
+		{
+			name:        "gitlab backend carries repo, label, and auth_env",
+			config:      "[tasks]\nbackend = \"gitlab\"\nrepo = \"acme/widgets\"\nlabel = \"bug\"\nauth_env = \"HIVE_GL_TOKEN\"\n",
+			wantName:    "gitlab",
+			wantRepo:    "acme/widgets",
+			wantLabel:   "bug",
+			wantAuthEnv: "HIVE_GL_TOKEN",
+		},
+		{
+			name:     "file backend",
+			config:   "[tasks]\nbackend = \"file\"\n",
+			wantName: "file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hiveDir := t.TempDir()
+			if tt.config != "" {
+				writeConfig(t, hiveDir, tt.config)
+			}
+
+			p := NewTaskProvider(hiveDir)
+			if p.Name() != tt.wantName {
+				t.Fatalf("Name() = %q, want %q", p.Name(), tt.wantName)
+			}
+
+			switch got := p.(type) {
+			case *githubTaskProvider:
+				if got.repo != tt.wantRepo || got.label != tt.wantLabel || got.authEnv != tt.wantAuthEnv {
+					t.Fatalf("githubTaskProvider = %+v, want repo=%q label=%q authEnv=%q", got, tt.wantRepo, tt.wantLabel, tt.wantAuthEnv)
+				}
+			case *gitlabTaskProvider:
+				if got.repo != tt.wantRepo || got.label != tt.wantLabel || got.authEnv != tt.wantAuthEnv {
+					t.Fatalf("gitlabTaskProvider = %+v, want repo=%q label=%q authEnv=%q", got, tt.wantRepo, tt.wantLabel, tt.wantAuthEnv)
+				}
+			}
+		})
+	}
+}